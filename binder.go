@@ -0,0 +1,164 @@
+package echo
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Binder is the interface that wraps the Bind method.
+	Binder interface {
+		Bind(Context, interface{}) error
+	}
+
+	binder struct{}
+)
+
+// Bind implements `Binder#Bind` function. Requests carrying a JSON or XML
+// body are decoded straight from the body. GET/DELETE requests, requests with
+// no Content-Type and URL-encoded or multipart form requests are instead bound
+// from the query string, falling back to form values and path parameters,
+// using the `query`/`form`/`param` struct tags. Any other Content-Type yields
+// a 415 Unsupported Media Type error.
+func (b *binder) Bind(c Context, i interface{}) (err error) {
+	req := c.Request()
+	ct := req.Header().Get(ContentType)
+	method := req.Method()
+
+	switch {
+	case strings.HasPrefix(ct, ApplicationJSON):
+		if err = json.NewDecoder(req.Body()).Decode(i); err != nil {
+			return NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return nil
+	case strings.HasPrefix(ct, ApplicationXML), strings.HasPrefix(ct, TextXML):
+		if err = xml.NewDecoder(req.Body()).Decode(i); err != nil {
+			return NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return nil
+	case method == GET, method == DELETE, ct == "":
+		return b.bindData(c, i)
+	case strings.HasPrefix(ct, ApplicationForm), strings.HasPrefix(ct, MultipartForm):
+		return b.bindData(c, i)
+	}
+	return NewHTTPError(http.StatusUnsupportedMediaType)
+}
+
+// bindData populates `i` from the request's query string, form values and
+// path parameters, in that order, matching fields by their `query`, `form`
+// and `param` struct tags respectively.
+func (b *binder) bindData(c Context, i interface{}) error {
+	val, err := bindTarget(i)
+	if err != nil {
+		return err
+	}
+	typ := val.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		fieldVal := val.Field(i)
+		if !fieldVal.CanSet() {
+			continue
+		}
+
+		if name := field.Tag.Get("query"); name != "" {
+			if values, ok := c.Request().URL().Query()[name]; ok {
+				if err := setFieldValue(fieldVal, values); err != nil {
+					return NewHTTPError(http.StatusBadRequest, err.Error())
+				}
+				continue
+			}
+		}
+		if name := field.Tag.Get("form"); name != "" {
+			if value := c.Form(name); value != "" {
+				if err := setFieldValue(fieldVal, []string{value}); err != nil {
+					return NewHTTPError(http.StatusBadRequest, err.Error())
+				}
+				continue
+			}
+		}
+		if name := field.Tag.Get("param"); name != "" {
+			if value := c.Param(name); value != "" {
+				if err := setFieldValue(fieldVal, []string{value}); err != nil {
+					return NewHTTPError(http.StatusBadRequest, err.Error())
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// bindTarget validates that `i` is a non-nil pointer to a struct and returns
+// the addressable struct value, so callers never hand a non-pointer to
+// reflect's `Elem`, which panics rather than returning an error.
+func bindTarget(i interface{}) (reflect.Value, error) {
+	val := reflect.ValueOf(i)
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		return reflect.Value{}, NewHTTPError(http.StatusBadRequest, "binding target must be a non-nil pointer to a struct")
+	}
+	val = val.Elem()
+	if val.Kind() != reflect.Struct {
+		return reflect.Value{}, NewHTTPError(http.StatusBadRequest, "binding target must be a pointer to a struct")
+	}
+	return val, nil
+}
+
+// setFieldValue converts `values` into `field`'s kind, handling slices by
+// converting each value individually.
+func setFieldValue(field reflect.Value, values []string) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	if field.Kind() == reflect.Slice {
+		slice := reflect.MakeSlice(field.Type(), len(values), len(values))
+		for i, value := range values {
+			if err := setScalarValue(slice.Index(i), value); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+		return nil
+	}
+
+	return setScalarValue(field, values[0])
+}
+
+// setScalarValue converts `value` into `field`'s primitive kind.
+func setScalarValue(field reflect.Value, value string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	default:
+		return NewHTTPError(http.StatusBadRequest, "unsupported field kind: "+field.Kind().String())
+	}
+	return nil
+}
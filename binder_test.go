@@ -0,0 +1,81 @@
+package echo
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+type bindTestTarget struct {
+	Name string
+	Age  int
+	Tags []string
+}
+
+func TestBindTarget(t *testing.T) {
+	if _, err := bindTarget(bindTestTarget{}); err == nil {
+		t.Error("expected error for non-pointer target, got nil")
+	}
+
+	var nilPtr *bindTestTarget
+	if _, err := bindTarget(nilPtr); err == nil {
+		t.Error("expected error for nil pointer target, got nil")
+	}
+
+	var notStruct int
+	if _, err := bindTarget(&notStruct); err == nil {
+		t.Error("expected error for pointer to non-struct, got nil")
+	}
+
+	val, err := bindTarget(&bindTestTarget{})
+	if err != nil {
+		t.Fatalf("unexpected error for valid pointer-to-struct: %v", err)
+	}
+	if val.Kind() != reflect.Struct {
+		t.Errorf("expected struct value, got %s", val.Kind())
+	}
+}
+
+func TestBindTargetErrorCode(t *testing.T) {
+	_, err := bindTarget(bindTestTarget{})
+	he, ok := err.(*HTTPError)
+	if !ok {
+		t.Fatalf("expected *HTTPError, got %T", err)
+	}
+	if he.Code != http.StatusBadRequest {
+		t.Errorf("Code = %d, want %d", he.Code, http.StatusBadRequest)
+	}
+}
+
+func TestSetFieldValue(t *testing.T) {
+	var tgt bindTestTarget
+	val, err := bindTarget(&tgt)
+	if err != nil {
+		t.Fatalf("bindTarget: %v", err)
+	}
+
+	if err := setFieldValue(val.FieldByName("Name"), []string{"gopher"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tgt.Name != "gopher" {
+		t.Errorf("Name = %q, want %q", tgt.Name, "gopher")
+	}
+
+	if err := setFieldValue(val.FieldByName("Age"), []string{"42"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tgt.Age != 42 {
+		t.Errorf("Age = %d, want 42", tgt.Age)
+	}
+
+	if err := setFieldValue(val.FieldByName("Tags"), []string{"a", "b"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tgt.Tags) != 2 || tgt.Tags[0] != "a" || tgt.Tags[1] != "b" {
+		t.Errorf("Tags = %v, want [a b]", tgt.Tags)
+	}
+
+	if err := setFieldValue(val.FieldByName("Age"), []string{"not-a-number"}); err == nil {
+		t.Error("expected error converting invalid int, got nil")
+	}
+}
@@ -0,0 +1,59 @@
+package echo
+
+import "net/http"
+
+// HTTP methods recognized by the default binder.
+const (
+	GET    = "GET"
+	DELETE = "DELETE"
+)
+
+// MIME types and header names used when reading and writing requests.
+const (
+	ApplicationJSON                  = "application/json"
+	ApplicationJSONCharsetUTF8       = ApplicationJSON + "; " + charsetUTF8
+	ApplicationJavaScriptCharsetUTF8 = "application/javascript; " + charsetUTF8
+	ApplicationXML                   = "application/xml"
+	ApplicationXMLCharsetUTF8        = ApplicationXML + "; " + charsetUTF8
+	TextXML                          = "text/xml"
+	ApplicationForm                  = "application/x-www-form-urlencoded"
+	MultipartForm                    = "multipart/form-data"
+	TextHTMLCharsetUTF8              = "text/html; " + charsetUTF8
+	TextPlainCharsetUTF8             = "text/plain; " + charsetUTF8
+
+	charsetUTF8 = "charset=utf-8"
+
+	ContentType        = "Content-Type"
+	ContentDisposition = "Content-Disposition"
+)
+
+// HTTPError represents an HTTP error with an optional message, returned by
+// handlers and middleware and surfaced by the registered `HTTPErrorHandler`.
+type HTTPError struct {
+	Code    int
+	Message string
+}
+
+// Error implements the `error` interface.
+func (e *HTTPError) Error() string {
+	return e.Message
+}
+
+// NewHTTPError creates a new `HTTPError` with the given status code. If the
+// first element of `message` is a string, it is used as the error message;
+// otherwise the standard text for `code` is used.
+func NewHTTPError(code int, message ...interface{}) *HTTPError {
+	he := &HTTPError{Code: code, Message: http.StatusText(code)}
+	if len(message) > 0 {
+		if s, ok := message[0].(string); ok {
+			he.Message = s
+		}
+	}
+	return he
+}
+
+// Sentinel errors returned by `Context` methods.
+var (
+	RendererNotRegistered = NewHTTPError(http.StatusInternalServerError, "renderer not registered")
+	InvalidRedirectCode   = NewHTTPError(http.StatusInternalServerError, "invalid redirect code")
+)
@@ -3,6 +3,8 @@ package echo
 import (
 	"encoding/json"
 	"encoding/xml"
+	"fmt"
+	"io"
 	"net/http"
 	"path/filepath"
 	"time"
@@ -40,29 +42,57 @@ type (
 		JSON(int, interface{}) error
 		JSONBlob(int, []byte) error
 		JSONP(int, string, interface{}) error
+		SetJSONEncoder(JSONEncoder)
 		XML(int, interface{}) error
 		XMLBlob(int, []byte) error
+		SetXMLEncoder(XMLEncoder)
 		File(string, string, bool) error
+		Stream(int, string, io.Reader) error
+		SSE(string, interface{}) error
 		NoContent(int) error
 		Redirect(int, string) error
+		RedirectRoute(name string, params ...interface{}) error
+		Negotiate(code int, data interface{}, offer ...NegotiateOffer) error
+		SetDeadline(time.Time)
+		WithValue(key, val interface{}) Context
 		Error(err error)
 		Logger() logger.Logger
 		Object() *context
 	}
 
 	context struct {
-		request  engine.Request
-		response engine.Response
-		socket   *websocket.Conn
-		path     string
-		pnames   []string
-		pvalues  []string
-		query    url.Values
-		store    store
-		echo     *Echo
+		request     engine.Request
+		response    engine.Response
+		socket      *websocket.Conn
+		path        string
+		pnames      []string
+		pvalues     []string
+		query       url.Values
+		store       store
+		echo        *Echo
+		jsonEncoder JSONEncoder
+		xmlEncoder  XMLEncoder
+		ctx         netContext.Context
+		cancel      netContext.CancelFunc
 	}
 
 	store map[string]interface{}
+
+	// SSEEvent wraps the `data` argument to `Context.SSE` when the event
+	// needs an `id:` and/or `retry:` line in addition to `data:`.
+	SSEEvent struct {
+		ID    string
+		Retry int
+		Data  interface{}
+	}
+
+	// JSONEncoder is the function signature for `Echo.SetJSONEncoder` and
+	// `Context.SetJSONEncoder`.
+	JSONEncoder func(w io.Writer, i interface{}, indent string) error
+
+	// XMLEncoder is the function signature for `Echo.SetXMLEncoder` and
+	// `Context.SetXMLEncoder`.
+	XMLEncoder func(w io.Writer, i interface{}, indent string) error
 )
 
 // NewContext creates a Context object.
@@ -73,23 +103,50 @@ func NewContext(req engine.Request, res engine.Response, e *Echo) Context {
 		echo:     e,
 		pvalues:  make([]string, *e.maxParam),
 		store:    make(store),
+		ctx:      req.Context(),
 	}
 }
 
+// Deadline returns the request context's deadline, if any.
 func (c *context) Deadline() (deadline time.Time, ok bool) {
-	return
+	return c.ctx.Deadline()
 }
 
+// Done returns the request context's done channel.
 func (c *context) Done() <-chan struct{} {
-	return nil
+	return c.ctx.Done()
 }
 
+// Err returns the request context's error.
 func (c *context) Err() error {
-	return nil
+	return c.ctx.Err()
 }
 
+// Value returns the request context's value for key.
 func (c *context) Value(key interface{}) interface{} {
-	return nil
+	return c.ctx.Value(key)
+}
+
+// SetDeadline cancels the request context at t. A deadline set earlier that
+// falls before t still applies.
+func (c *context) SetDeadline(t time.Time) {
+	prev := c.cancel
+	ctx, cancel := netContext.WithDeadline(c.ctx, t)
+	c.ctx = ctx
+	c.cancel = func() {
+		cancel()
+		if prev != nil {
+			prev()
+		}
+	}
+}
+
+// WithValue returns a copy of the Context carrying val for key.
+func (c *context) WithValue(key, val interface{}) Context {
+	clone := *c
+	clone.ctx = netContext.WithValue(c.ctx, key, val)
+	clone.cancel = nil
+	return &clone
 }
 
 // Request returns *http.Request.
@@ -156,10 +213,13 @@ func (c *context) Set(key string, val interface{}) {
 	c.store[key] = val
 }
 
-// Bind binds the request body into specified type `i`. The default binder does
-// it based on Content-Type header.
+// Bind binds the request into specified type `i`. The default binder does it
+// based on Content-Type header. For GET/DELETE requests, or any request with
+// no Content-Type, it instead populates `i` from the query string, falling
+// back to form values and path parameters, using the `query`/`form`/`param`
+// struct tags.
 func (c *context) Bind(i interface{}) error {
-	return c.echo.binder.Bind(c.request, i)
+	return c.echo.binder.Bind(c, i)
 }
 
 // Render renders a template with data and sends a text/html response with status
@@ -194,16 +254,23 @@ func (c *context) String(code int, s string) (err error) {
 	return
 }
 
-// JSON sends a JSON response with status code.
+// JSON sends a JSON response with status code, streaming the encode directly
+// onto the response.
 func (c *context) JSON(code int, i interface{}) (err error) {
-	b, err := json.Marshal(i)
+	indent := ""
 	if c.echo.Debug() {
-		b, err = json.MarshalIndent(i, "", "  ")
+		indent = "  "
 	}
-	if err != nil {
-		return err
+	c.response.Header().Set(ContentType, ApplicationJSONCharsetUTF8)
+	c.response.WriteHeader(code)
+	enc := c.jsonEncoder
+	if enc == nil {
+		enc = c.echo.jsonEncoder
+	}
+	if enc == nil {
+		enc = defaultJSONEncoder
 	}
-	return c.JSONBlob(code, b)
+	return enc(c.response, i, indent)
 }
 
 // JSONBlob sends a JSON blob response with status code.
@@ -214,6 +281,21 @@ func (c *context) JSONBlob(code int, b []byte) (err error) {
 	return
 }
 
+// SetJSONEncoder overrides the JSONEncoder used by JSON for this context.
+func (c *context) SetJSONEncoder(enc JSONEncoder) {
+	c.jsonEncoder = enc
+}
+
+// defaultJSONEncoder is used when neither the context nor the Echo instance
+// have a JSONEncoder configured.
+func defaultJSONEncoder(w io.Writer, i interface{}, indent string) error {
+	enc := json.NewEncoder(w)
+	if indent != "" {
+		enc.SetIndent("", indent)
+	}
+	return enc.Encode(i)
+}
+
 // JSONP sends a JSONP response with status code. It uses `callback` to construct
 // the JSONP payload.
 func (c *context) JSONP(code int, callback string, i interface{}) (err error) {
@@ -229,16 +311,24 @@ func (c *context) JSONP(code int, callback string, i interface{}) (err error) {
 	return
 }
 
-// XML sends an XML response with status code.
+// XML sends an XML response with status code, streaming the encode directly
+// onto the response.
 func (c *context) XML(code int, i interface{}) (err error) {
-	b, err := xml.Marshal(i)
+	indent := ""
 	if c.echo.Debug() {
-		b, err = xml.MarshalIndent(i, "", "  ")
+		indent = "  "
 	}
-	if err != nil {
-		return err
+	c.response.Header().Set(ContentType, ApplicationXMLCharsetUTF8)
+	c.response.WriteHeader(code)
+	c.response.Write([]byte(xml.Header))
+	enc := c.xmlEncoder
+	if enc == nil {
+		enc = c.echo.xmlEncoder
 	}
-	return c.XMLBlob(code, b)
+	if enc == nil {
+		enc = defaultXMLEncoder
+	}
+	return enc(c.response, i, indent)
 }
 
 // XMLBlob sends a XML blob response with status code.
@@ -250,6 +340,21 @@ func (c *context) XMLBlob(code int, b []byte) (err error) {
 	return
 }
 
+// SetXMLEncoder overrides the XMLEncoder used by XML for this context.
+func (c *context) SetXMLEncoder(enc XMLEncoder) {
+	c.xmlEncoder = enc
+}
+
+// defaultXMLEncoder is used when neither the context nor the Echo instance
+// have an XMLEncoder configured.
+func defaultXMLEncoder(w io.Writer, i interface{}, indent string) error {
+	enc := xml.NewEncoder(w)
+	if indent != "" {
+		enc.Indent("", indent)
+	}
+	return enc.Encode(i)
+}
+
 // File sends a response with the content of the file. If `attachment` is set
 // to true, the client is prompted to save the file with provided `name`,
 // name can be empty, in that case name of the file is used.
@@ -264,22 +369,116 @@ func (c *context) File(path, name string, attachment bool) (err error) {
 	return
 }
 
+// Stream sends a streaming response with status code and content type,
+// flushing the response as r is read. Handlers commonly feed r from a
+// goroutine writing to a pipe, blocking between chunks (goroutine-per-connection).
+func (c *context) Stream(code int, contentType string, r io.Reader) (err error) {
+	c.response.Header().Set(ContentType, contentType)
+	c.response.WriteHeader(code)
+	buf := make([]byte, 2048)
+	for {
+		n, rerr := r.Read(buf)
+		if n > 0 {
+			if _, werr := c.response.Write(buf[:n]); werr != nil {
+				return werr
+			}
+			c.response.Flush()
+		}
+		if rerr != nil {
+			if rerr == io.EOF {
+				return nil
+			}
+			return rerr
+		}
+	}
+}
+
+// SSE writes data as a Server-Sent Event and flushes it immediately. event
+// names the event type and may be empty. Handlers commonly call SSE in a
+// loop, blocking on a channel of events (goroutine-per-connection).
+func (c *context) SSE(event string, data interface{}) (err error) {
+	c.response.Header().Set(ContentType, "text/event-stream")
+	c.response.Header().Set("Cache-Control", "no-cache")
+	c.response.Header().Set("Connection", "keep-alive")
+
+	b, err := formatSSE(event, data)
+	if err != nil {
+		return err
+	}
+	if _, err = c.response.Write(b); err != nil {
+		return err
+	}
+	c.response.Flush()
+	return nil
+}
+
+// formatSSE renders event/data into the wire format defined by the
+// EventSource spec: an optional `event:` line, `id:`/`retry:` lines when
+// data is an SSEEvent, one or more `data:` lines, and a trailing blank line.
+func formatSSE(event string, data interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if event != "" {
+		fmt.Fprintf(&buf, "event: %s\n", event)
+	}
+
+	if sse, ok := data.(SSEEvent); ok {
+		if sse.ID != "" {
+			fmt.Fprintf(&buf, "id: %s\n", sse.ID)
+		}
+		if sse.Retry > 0 {
+			fmt.Fprintf(&buf, "retry: %d\n", sse.Retry)
+		}
+		data = sse.Data
+	}
+
+	var payload []byte
+	switch v := data.(type) {
+	case []byte:
+		payload = v
+	case string:
+		payload = []byte(v)
+	default:
+		b, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		payload = b
+	}
+	for _, line := range bytes.Split(payload, []byte("\n")) {
+		fmt.Fprintf(&buf, "data: %s\n", line)
+	}
+	buf.WriteByte('\n')
+
+	return buf.Bytes(), nil
+}
+
 // NoContent sends a response with no body and a status code.
 func (c *context) NoContent(code int) error {
 	c.response.WriteHeader(code)
 	return nil
 }
 
-// Redirect redirects the request using http.Redirect with status code.
+// Redirect redirects the request to the provided URL with status code.
 func (c *context) Redirect(code int, url string) error {
 	if code < http.StatusMultipleChoices || code > http.StatusTemporaryRedirect {
 		return InvalidRedirectCode
 	}
-	// TODO: v2
-	// http.Redirect(c.response, c.request, url, code)
+	c.response.Header().Set("Location", url)
+	c.response.WriteHeader(code)
 	return nil
 }
 
+// RedirectRoute reverses the named route and redirects to it with a 302
+// Found.
+func (c *context) RedirectRoute(name string, params ...interface{}) error {
+	url, err := c.echo.router.Reverse(name, params...)
+	if err != nil {
+		return err
+	}
+	return c.Redirect(http.StatusFound, url)
+}
+
 // Error invokes the registered HTTP error handler. Generally used by middleware.
 func (c *context) Error(err error) {
 	c.echo.httpErrorHandler(err, c)
@@ -296,9 +495,16 @@ func (c *context) Object() *context {
 }
 
 func (c *context) reset(req engine.Request, res engine.Response, e *Echo) {
+	if c.cancel != nil {
+		c.cancel()
+	}
 	c.request = req
 	c.response = res
 	c.query = nil
 	c.store = nil
 	c.echo = e
+	c.jsonEncoder = nil
+	c.xmlEncoder = nil
+	c.ctx = req.Context()
+	c.cancel = nil
 }
@@ -0,0 +1,74 @@
+package echo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/engine/standard"
+)
+
+func newTestContext() Context {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	return NewContext(standard.NewRequest(req), standard.NewResponse(rec), New())
+}
+
+func TestSetDeadlineCancelsDoneAndErr(t *testing.T) {
+	c := newTestContext()
+	c.SetDeadline(time.Now().Add(-time.Second))
+
+	select {
+	case <-c.Done():
+	default:
+		t.Fatal("Done() channel should be closed for an already-elapsed deadline")
+	}
+	if c.Err() == nil {
+		t.Error("expected a non-nil Err() after the deadline elapsed")
+	}
+}
+
+func TestWithTimeoutCancelsContextAfterHandler(t *testing.T) {
+	c := newTestContext()
+	var observedErr error
+
+	h := WithTimeout(10 * time.Millisecond)(func(c Context) error {
+		observedErr = c.Err()
+		return nil
+	})
+	if err := h(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if observedErr != nil {
+		t.Errorf("handler observed Err() = %v, want nil before the timeout elapses", observedErr)
+	}
+
+	<-time.After(20 * time.Millisecond)
+	if c.Err() == nil {
+		t.Error("expected Err() to be set once the WithTimeout deadline elapses")
+	}
+}
+
+func TestWithValueDoesNotShareCancelWithParent(t *testing.T) {
+	c := newTestContext()
+	c.SetDeadline(time.Now().Add(time.Hour))
+
+	clone := c.WithValue("key", "value")
+	clone.SetDeadline(time.Now().Add(time.Hour))
+
+	if c.Err() != nil {
+		t.Errorf("parent Err() = %v, want nil — WithValue must not let the clone cancel it", c.Err())
+	}
+	if clone.Err() != nil {
+		t.Errorf("clone Err() = %v, want nil", clone.Err())
+	}
+}
+
+func TestWithValuePreservesValue(t *testing.T) {
+	c := newTestContext()
+	clone := c.WithValue("key", "value")
+	if v := clone.Value("key"); v != "value" {
+		t.Errorf("Value(%q) = %v, want %q", "key", v, "value")
+	}
+}
@@ -0,0 +1,125 @@
+package echo
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/engine/standard"
+)
+
+type jsonTestPayload struct {
+	Name string `json:"name"`
+}
+
+func newTestContextWith(e *Echo) (Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	return NewContext(standard.NewRequest(req), standard.NewResponse(rec), e), rec
+}
+
+func TestContextJSONStreamsBody(t *testing.T) {
+	c, rec := newTestContextWith(New())
+	if err := c.JSON(http.StatusOK, jsonTestPayload{Name: "gopher"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if ct := rec.Header().Get(ContentType); ct != ApplicationJSONCharsetUTF8 {
+		t.Errorf("Content-Type = %q, want %q", ct, ApplicationJSONCharsetUTF8)
+	}
+
+	var got jsonTestPayload
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("body did not decode as JSON: %v", err)
+	}
+	if got.Name != "gopher" {
+		t.Errorf("Name = %q, want %q", got.Name, "gopher")
+	}
+}
+
+func TestContextJSONIndentsInDebugMode(t *testing.T) {
+	e := New()
+	e.SetDebug(true)
+	c, rec := newTestContextWith(e)
+
+	if err := c.JSON(http.StatusOK, jsonTestPayload{Name: "gopher"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(rec.Body.String(), "\n  \"name\"") {
+		t.Errorf("body = %q, want indented (multi-line) JSON in debug mode", rec.Body.String())
+	}
+}
+
+func TestContextSetJSONEncoderOverridesEchoEncoder(t *testing.T) {
+	e := New()
+	e.SetJSONEncoder(func(w io.Writer, i interface{}, indent string) error {
+		_, err := w.Write([]byte("echo-encoder"))
+		return err
+	})
+	c, rec := newTestContextWith(e)
+	c.SetJSONEncoder(func(w io.Writer, i interface{}, indent string) error {
+		_, err := w.Write([]byte("context-encoder"))
+		return err
+	})
+
+	if err := c.JSON(http.StatusOK, jsonTestPayload{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := rec.Body.String(); got != "context-encoder" {
+		t.Errorf("body = %q, want the context-level encoder to take precedence over Echo's", got)
+	}
+}
+
+func TestContextJSONFallsBackToEchoEncoder(t *testing.T) {
+	e := New()
+	e.SetJSONEncoder(func(w io.Writer, i interface{}, indent string) error {
+		_, err := w.Write([]byte("echo-encoder"))
+		return err
+	})
+	c, rec := newTestContextWith(e)
+
+	if err := c.JSON(http.StatusOK, jsonTestPayload{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := rec.Body.String(); got != "echo-encoder" {
+		t.Errorf("body = %q, want Echo's encoder used when the context has none set", got)
+	}
+}
+
+func TestContextXMLStreamsBody(t *testing.T) {
+	c, rec := newTestContextWith(New())
+	if err := c.XML(http.StatusOK, jsonTestPayload{Name: "gopher"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ct := rec.Header().Get(ContentType); ct != ApplicationXMLCharsetUTF8 {
+		t.Errorf("Content-Type = %q, want %q", ct, ApplicationXMLCharsetUTF8)
+	}
+	if !strings.Contains(rec.Body.String(), "<jsonTestPayload>") {
+		t.Errorf("body = %q, want encoded XML", rec.Body.String())
+	}
+}
+
+func TestContextSetXMLEncoderOverridesEchoEncoder(t *testing.T) {
+	e := New()
+	e.SetXMLEncoder(func(w io.Writer, i interface{}, indent string) error {
+		_, err := w.Write([]byte("echo-encoder"))
+		return err
+	})
+	c, rec := newTestContextWith(e)
+	c.SetXMLEncoder(func(w io.Writer, i interface{}, indent string) error {
+		_, err := w.Write([]byte("context-encoder"))
+		return err
+	})
+
+	if err := c.XML(http.StatusOK, jsonTestPayload{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := rec.Body.String(); !strings.HasSuffix(got, "context-encoder") {
+		t.Errorf("body = %q, want the context-level encoder to take precedence over Echo's", got)
+	}
+}
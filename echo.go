@@ -0,0 +1,141 @@
+package echo
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path"
+
+	"github.com/labstack/echo/logger"
+)
+
+type (
+	// Renderer is the interface that wraps the Render method, implemented by
+	// template engines registered via `Echo.SetRenderer`.
+	Renderer interface {
+		Render(w io.Writer, name string, data interface{}) error
+	}
+
+	// HTTPErrorHandler is the function signature for handling errors
+	// returned from handlers and middleware, set via
+	// `Echo.SetHTTPErrorHandler`.
+	HTTPErrorHandler func(error, Context)
+
+	// Echo is the top-level framework instance. It holds the shared
+	// configuration (binder, renderer, logger, encoders, error handler) that
+	// is threaded into every `Context` created via `NewContext`.
+	Echo struct {
+		maxParam         *int
+		binder           Binder
+		renderer         Renderer
+		logger           logger.Logger
+		httpErrorHandler HTTPErrorHandler
+		debug            bool
+		jsonEncoder      JSONEncoder
+		xmlEncoder       XMLEncoder
+		router           *Router
+		negotiators      map[string]func(Context, int, interface{}) error
+	}
+)
+
+// New creates an instance of `Echo`.
+func New() (e *Echo) {
+	e = &Echo{
+		maxParam: new(int),
+		binder:   &binder{},
+		router:   NewRouter(),
+	}
+	e.httpErrorHandler = e.defaultHTTPErrorHandler
+	return
+}
+
+// Debug returns whether debug mode is enabled.
+func (e *Echo) Debug() bool {
+	return e.debug
+}
+
+// Router returns the `Router` used to reverse named routes for
+// `Context.RedirectRoute`. Applications register their named routes against
+// it (`e.Router().Add("user", "/users/:id")`) alongside their normal route
+// registration.
+func (e *Echo) Router() *Router {
+	return e.router
+}
+
+// SetDebug enables/disables debug mode. In debug mode, `Context.JSON`/`XML`
+// indent their output.
+func (e *Echo) SetDebug(on bool) {
+	e.debug = on
+}
+
+// SetBinder registers `b` as the binder used by `Context.Bind`.
+func (e *Echo) SetBinder(b Binder) {
+	e.binder = b
+}
+
+// SetRenderer registers `r` as the renderer used by `Context.Render`.
+func (e *Echo) SetRenderer(r Renderer) {
+	e.renderer = r
+}
+
+// SetLogger registers `l` as the logger returned by `Context.Logger`.
+func (e *Echo) SetLogger(l logger.Logger) {
+	e.logger = l
+}
+
+// SetHTTPErrorHandler registers `h` as the handler invoked by
+// `Context.Error`.
+func (e *Echo) SetHTTPErrorHandler(h HTTPErrorHandler) {
+	e.httpErrorHandler = h
+}
+
+// SetJSONEncoder registers `enc` as the default `JSONEncoder` used by
+// `Context.JSON`, for every context that hasn't overridden it with
+// `Context.SetJSONEncoder`. Use it to plug in a faster or customized
+// encoder (e.g. jsoniter, goccy/go-json) in place of `encoding/json`.
+func (e *Echo) SetJSONEncoder(enc JSONEncoder) {
+	e.jsonEncoder = enc
+}
+
+// SetXMLEncoder registers `enc` as the default `XMLEncoder` used by
+// `Context.XML`, for every context that hasn't overridden it with
+// `Context.SetXMLEncoder`.
+func (e *Echo) SetXMLEncoder(enc XMLEncoder) {
+	e.xmlEncoder = enc
+}
+
+// defaultHTTPErrorHandler is the `HTTPErrorHandler` used when none is
+// registered via `SetHTTPErrorHandler`.
+func (e *Echo) defaultHTTPErrorHandler(err error, c Context) {
+	code := http.StatusInternalServerError
+	msg := err.Error()
+	if he, ok := err.(*HTTPError); ok {
+		code = he.Code
+		msg = he.Message
+	}
+	if e.logger != nil {
+		e.logger.Print(err)
+	}
+	c.String(code, msg)
+}
+
+// serveFile serves `file` out of `dir` onto `c`'s response.
+func (e *Echo) serveFile(dir, file string, c Context) error {
+	f, err := os.Open(path.Join(dir, file))
+	if err != nil {
+		return NewHTTPError(http.StatusNotFound)
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return NewHTTPError(http.StatusNotFound)
+	}
+	if fi.IsDir() {
+		return NewHTTPError(http.StatusNotFound)
+	}
+
+	c.Response().WriteHeader(http.StatusOK)
+	_, err = io.Copy(c.Response(), f)
+	return err
+}
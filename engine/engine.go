@@ -0,0 +1,47 @@
+// Package engine abstracts the HTTP request/response pair so that Echo can
+// run on top of different transports (the standard library, fasthttp, ...)
+// behind a common interface.
+package engine
+
+import (
+	"io"
+
+	netContext "golang.org/x/net/context"
+)
+
+type (
+	// Header represents the HTTP header of a request or response.
+	Header interface {
+		Get(string) string
+		Set(string, string)
+		Del(string)
+	}
+
+	// URL represents a request URL.
+	URL interface {
+		QueryValue(string) string
+		Query() map[string][]string
+	}
+
+	// Request represents an HTTP request.
+	Request interface {
+		Header() Header
+		URL() URL
+		FormValue(string) string
+		Method() string
+		Body() io.Reader
+		// Context returns the request's cancelation context, canceled when
+		// the underlying connection is closed (e.g. the client disconnects).
+		Context() netContext.Context
+	}
+
+	// Response represents an HTTP response.
+	Response interface {
+		Header() Header
+		Write([]byte) (int, error)
+		WriteHeader(int)
+		// Flush sends any buffered data to the client, used by Context.Stream
+		// and Context.SSE to deliver chunks as they are produced.
+		Flush()
+	}
+)
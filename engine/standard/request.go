@@ -0,0 +1,75 @@
+// Package standard implements engine.Request/engine.Response on top of
+// net/http, for running Echo as a standard http.Handler.
+package standard
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/labstack/echo/engine"
+	netContext "golang.org/x/net/context"
+)
+
+type (
+	// Request implements engine.Request on top of *http.Request.
+	Request struct {
+		*http.Request
+	}
+
+	header struct {
+		http.Header
+	}
+
+	requestURL struct {
+		*url.URL
+	}
+)
+
+// NewRequest wraps r as an engine.Request.
+func NewRequest(r *http.Request) *Request {
+	return &Request{Request: r}
+}
+
+// Header returns the request header.
+func (r *Request) Header() engine.Header {
+	return &header{Header: r.Request.Header}
+}
+
+// URL returns the request URL.
+func (r *Request) URL() engine.URL {
+	return &requestURL{URL: r.Request.URL}
+}
+
+// FormValue returns the form value for name.
+func (r *Request) FormValue(name string) string {
+	return r.Request.FormValue(name)
+}
+
+// Method returns the request's HTTP method.
+func (r *Request) Method() string {
+	return r.Request.Method
+}
+
+// Body returns the request body.
+func (r *Request) Body() io.Reader {
+	return r.Request.Body
+}
+
+// Context returns the underlying *http.Request's context, which is canceled
+// once the client disconnects or the request's deadline elapses.
+func (r *Request) Context() netContext.Context {
+	return r.Request.Context()
+}
+
+func (h *header) Get(name string) string { return h.Header.Get(name) }
+func (h *header) Set(name, value string) { h.Header.Set(name, value) }
+func (h *header) Del(name string)        { h.Header.Del(name) }
+
+func (u *requestURL) QueryValue(name string) string {
+	return u.URL.Query().Get(name)
+}
+
+func (u *requestURL) Query() map[string][]string {
+	return map[string][]string(u.URL.Query())
+}
@@ -0,0 +1,35 @@
+package standard
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/engine"
+)
+
+// Response implements engine.Response on top of http.ResponseWriter.
+type Response struct {
+	http.ResponseWriter
+}
+
+// NewResponse wraps w as an engine.Response.
+func NewResponse(w http.ResponseWriter) *Response {
+	return &Response{ResponseWriter: w}
+}
+
+// Header returns the response header.
+func (r *Response) Header() engine.Header {
+	return &header{Header: r.ResponseWriter.Header()}
+}
+
+// WriteHeader sends an HTTP response header with the given status code.
+func (r *Response) WriteHeader(code int) {
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// Flush sends any buffered data to the client, if the underlying
+// http.ResponseWriter supports it.
+func (r *Response) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
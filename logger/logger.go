@@ -0,0 +1,9 @@
+// Package logger defines the logging interface used throughout Echo.
+package logger
+
+// Logger is the interface that wraps the logging methods used by Echo and
+// its middleware, set via Echo.SetLogger.
+type Logger interface {
+	Print(...interface{})
+	Printf(string, ...interface{})
+}
@@ -0,0 +1,118 @@
+package echo
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type (
+	// NegotiateOffer customizes what `Context.Negotiate` renders for a given
+	// request. HTMLName names the template to pass to `Render` when the
+	// client accepts `text/html`; it is ignored (and `text/html` is treated
+	// as unavailable) when left empty.
+	NegotiateOffer struct {
+		HTMLName string
+	}
+
+	// accept is a single parsed entry from an `Accept` header, e.g.
+	// `application/json;q=0.8`.
+	accept struct {
+		typ, subtype string
+		q            float64
+	}
+)
+
+// matches reports whether `a` accepts the concrete `typ/subtype` mime,
+// honoring the `*` and `type/*` wildcards.
+func (a accept) matches(typ, subtype string) bool {
+	return (a.typ == "*" || a.typ == typ) && (a.subtype == "*" || a.subtype == subtype)
+}
+
+// parseAccept parses an `Accept` header into its entries, sorted by
+// descending q-value (ties keep their original order).
+func parseAccept(header string) []accept {
+	if header == "" {
+		return nil
+	}
+
+	parts := strings.Split(header, ",")
+	accepts := make([]accept, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		segments := strings.Split(part, ";")
+		mime := strings.ToLower(strings.TrimSpace(segments[0]))
+		typ, subtype := mime, "*"
+		if i := strings.IndexByte(mime, '/'); i >= 0 {
+			typ, subtype = mime[:i], mime[i+1:]
+		}
+
+		q := 1.0
+		for _, param := range segments[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if f, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = f
+				}
+			}
+		}
+		if q <= 0 {
+			// Client explicitly refused this type; don't offer it.
+			continue
+		}
+
+		accepts = append(accepts, accept{typ: typ, subtype: subtype, q: q})
+	}
+
+	sort.SliceStable(accepts, func(i, j int) bool {
+		return accepts[i].q > accepts[j].q
+	})
+	return accepts
+}
+
+// Negotiate renders data using whichever renderer best matches the request's
+// Accept header, falling back to 406 Not Acceptable when nothing matches.
+func (c *context) Negotiate(code int, data interface{}, offer ...NegotiateOffer) error {
+	var opt NegotiateOffer
+	if len(offer) > 0 {
+		opt = offer[0]
+	}
+
+	accepts := parseAccept(c.request.Header().Get("Accept"))
+	if len(accepts) == 0 {
+		accepts = []accept{{typ: "*", subtype: "*", q: 1}}
+	}
+
+	for _, a := range accepts {
+		switch {
+		case a.matches("application", "json"):
+			return c.JSON(code, data)
+		case a.matches("application", "xml"), a.matches("text", "xml"):
+			return c.XML(code, data)
+		case a.matches("text", "html") && opt.HTMLName != "":
+			return c.Render(code, opt.HTMLName, data)
+		case a.matches("text", "plain"):
+			return c.String(code, fmt.Sprint(data))
+		}
+		if fn, ok := c.echo.negotiators[a.typ+"/"+a.subtype]; ok {
+			return fn(c, code, data)
+		}
+	}
+
+	return NewHTTPError(http.StatusNotAcceptable)
+}
+
+// RegisterRenderer registers fn as the renderer Negotiate uses for mime,
+// e.g. "application/x-msgpack". Matching is case-insensitive.
+func (e *Echo) RegisterRenderer(mime string, fn func(Context, int, interface{}) error) {
+	if e.negotiators == nil {
+		e.negotiators = make(map[string]func(Context, int, interface{}) error)
+	}
+	e.negotiators[strings.ToLower(mime)] = fn
+}
@@ -0,0 +1,90 @@
+package echo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/engine/standard"
+)
+
+func TestParseAcceptOrdersByQValue(t *testing.T) {
+	accepts := parseAccept("text/plain;q=0.5, application/json;q=0.9, text/html")
+	if len(accepts) != 3 {
+		t.Fatalf("got %d entries, want 3", len(accepts))
+	}
+	if accepts[0].typ != "text" || accepts[0].subtype != "html" {
+		t.Errorf("accepts[0] = %+v, want text/html (q=1, default)", accepts[0])
+	}
+	if accepts[1].typ != "application" || accepts[1].subtype != "json" {
+		t.Errorf("accepts[1] = %+v, want application/json (q=0.9)", accepts[1])
+	}
+	if accepts[2].typ != "text" || accepts[2].subtype != "plain" {
+		t.Errorf("accepts[2] = %+v, want text/plain (q=0.5)", accepts[2])
+	}
+}
+
+func TestParseAcceptRejectsQZero(t *testing.T) {
+	accepts := parseAccept("application/json;q=0, text/html")
+	for _, a := range accepts {
+		if a.matches("application", "json") {
+			t.Errorf("q=0 entry %+v should have been dropped", a)
+		}
+	}
+	if len(accepts) != 1 {
+		t.Fatalf("got %d entries, want 1", len(accepts))
+	}
+}
+
+func TestParseAcceptIsCaseInsensitive(t *testing.T) {
+	accepts := parseAccept("Application/JSON")
+	if len(accepts) != 1 {
+		t.Fatalf("got %d entries, want 1", len(accepts))
+	}
+	if !accepts[0].matches("application", "json") {
+		t.Errorf("accepts[0] = %+v, want to match application/json", accepts[0])
+	}
+}
+
+func TestAcceptMatchesWildcards(t *testing.T) {
+	a := accept{typ: "*", subtype: "*", q: 1}
+	if !a.matches("application", "json") {
+		t.Error("*/* should match application/json")
+	}
+
+	b := accept{typ: "text", subtype: "*", q: 1}
+	if !b.matches("text", "html") {
+		t.Error("text/* should match text/html")
+	}
+	if b.matches("application", "json") {
+		t.Error("text/* should not match application/json")
+	}
+}
+
+func TestParseAcceptStableOnTies(t *testing.T) {
+	accepts := parseAccept("text/plain, application/json, text/html")
+	if accepts[0].subtype != "plain" || accepts[1].subtype != "json" || accepts[2].subtype != "html" {
+		t.Errorf("expected original order preserved on ties, got %+v", accepts)
+	}
+}
+
+func TestNegotiateUsesRegisteredRenderer(t *testing.T) {
+	e := New()
+	called := false
+	e.RegisterRenderer("Application/X-Msgpack", func(c Context, code int, data interface{}) error {
+		called = true
+		return c.String(code, "rendered")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept", "application/x-msgpack")
+	rec := httptest.NewRecorder()
+	c := NewContext(standard.NewRequest(req), standard.NewResponse(rec), e)
+
+	if err := c.Negotiate(http.StatusOK, "payload"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Error("registered renderer was not invoked for a case-differing mime")
+	}
+}
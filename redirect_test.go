@@ -0,0 +1,35 @@
+package echo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/engine/standard"
+)
+
+func TestContextRedirectRejectsInvalidCode(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := NewContext(standard.NewRequest(req), standard.NewResponse(rec), New())
+
+	if err := c.Redirect(http.StatusOK, "/elsewhere"); err != InvalidRedirectCode {
+		t.Errorf("err = %v, want InvalidRedirectCode", err)
+	}
+}
+
+func TestContextRedirectSetsLocationAndStatus(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := NewContext(standard.NewRequest(req), standard.NewResponse(rec), New())
+
+	if err := c.Redirect(http.StatusFound, "/elsewhere"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.Code != http.StatusFound {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusFound)
+	}
+	if loc := rec.Header().Get("Location"); loc != "/elsewhere" {
+		t.Errorf("Location = %q, want %q", loc, "/elsewhere")
+	}
+}
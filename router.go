@@ -0,0 +1,48 @@
+package echo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Router stores named routes and reverses them back into URLs. It does not
+// match incoming requests against them.
+type Router struct {
+	routes map[string]string // name -> path pattern, e.g. "/users/:id"
+}
+
+// NewRouter creates an empty Router.
+func NewRouter() *Router {
+	return &Router{routes: make(map[string]string)}
+}
+
+// Add registers path under name so it can later be reversed.
+func (r *Router) Add(name, path string) {
+	r.routes[name] = path
+}
+
+// Reverse substitutes params, in order, for the `:param` segments of the
+// route registered as name, and returns the resulting URL. It returns an
+// error if name was never registered.
+func (r *Router) Reverse(name string, params ...interface{}) (string, error) {
+	path, ok := r.routes[name]
+	if !ok {
+		return "", NewHTTPError(404, fmt.Sprintf("route %q not found", name))
+	}
+
+	segments := strings.Split(path, "/")
+	uri := new(strings.Builder)
+	pi := 0
+	for i, segment := range segments {
+		if i > 0 {
+			uri.WriteByte('/')
+		}
+		if strings.HasPrefix(segment, ":") && pi < len(params) {
+			fmt.Fprintf(uri, "%v", params[pi])
+			pi++
+			continue
+		}
+		uri.WriteString(segment)
+	}
+	return uri.String(), nil
+}
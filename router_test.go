@@ -0,0 +1,60 @@
+package echo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/engine/standard"
+)
+
+func TestRouterAddAndReverse(t *testing.T) {
+	r := NewRouter()
+	r.Add("user", "/users/:id")
+
+	url, err := r.Reverse("user", 42)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if url != "/users/42" {
+		t.Errorf("got %q, want %q", url, "/users/42")
+	}
+}
+
+func TestRouterReverseUnknownRoute(t *testing.T) {
+	r := NewRouter()
+	if _, err := r.Reverse("missing"); err == nil {
+		t.Error("expected error for an unregistered route, got nil")
+	}
+}
+
+func TestRedirectRouteEndToEnd(t *testing.T) {
+	e := New()
+	e.Router().Add("user", "/users/:id")
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := NewContext(standard.NewRequest(req), standard.NewResponse(rec), e)
+
+	if err := c.RedirectRoute("user", 42); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.Code != http.StatusFound {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusFound)
+	}
+	if loc := rec.Header().Get("Location"); loc != "/users/42" {
+		t.Errorf("Location = %q, want %q", loc, "/users/42")
+	}
+}
+
+func TestRedirectRouteUnknownRoute(t *testing.T) {
+	e := New()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := NewContext(standard.NewRequest(req), standard.NewResponse(rec), e)
+
+	if err := c.RedirectRoute("missing"); err == nil {
+		t.Error("expected error for an unregistered route, got nil")
+	}
+}
@@ -0,0 +1,119 @@
+package echo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/engine/standard"
+)
+
+func TestFormatSSEPlainData(t *testing.T) {
+	b, err := formatSSE("", "hello")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "data: hello\n\n"
+	if string(b) != want {
+		t.Errorf("got %q, want %q", b, want)
+	}
+}
+
+func TestFormatSSEWithEventName(t *testing.T) {
+	b, err := formatSSE("ping", "hello")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(string(b), "event: ping\n") {
+		t.Errorf("got %q, want event: line first", b)
+	}
+	if !strings.HasSuffix(string(b), "data: hello\n\n") {
+		t.Errorf("got %q, want trailing data: line", b)
+	}
+}
+
+func TestFormatSSEEventWithIDAndRetry(t *testing.T) {
+	b, err := formatSSE("", SSEEvent{ID: "42", Retry: 1000, Data: "hello"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "id: 42\nretry: 1000\ndata: hello\n\n"
+	if string(b) != want {
+		t.Errorf("got %q, want %q", b, want)
+	}
+}
+
+func TestFormatSSEMultilineData(t *testing.T) {
+	b, err := formatSSE("", "line1\nline2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "data: line1\ndata: line2\n\n"
+	if string(b) != want {
+		t.Errorf("got %q, want %q", b, want)
+	}
+}
+
+func TestFormatSSEStructData(t *testing.T) {
+	b, err := formatSSE("", struct {
+		Foo string `json:"foo"`
+	}{Foo: "bar"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `data: {"foo":"bar"}` + "\n\n"
+	if string(b) != want {
+		t.Errorf("got %q, want %q", b, want)
+	}
+}
+
+func TestFormatSSEByteData(t *testing.T) {
+	b, err := formatSSE("", []byte("raw"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "data: raw\n\n"
+	if string(b) != want {
+		t.Errorf("got %q, want %q", b, want)
+	}
+}
+
+func TestContextStreamCopiesReaderToResponse(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := NewContext(standard.NewRequest(req), standard.NewResponse(rec), New())
+
+	if err := c.Stream(http.StatusOK, "text/plain", strings.NewReader("chunk1chunk2")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if ct := rec.Header().Get(ContentType); ct != "text/plain" {
+		t.Errorf("Content-Type = %q, want %q", ct, "text/plain")
+	}
+	if got := rec.Body.String(); got != "chunk1chunk2" {
+		t.Errorf("body = %q, want %q", got, "chunk1chunk2")
+	}
+}
+
+func TestContextSSEWritesWireFormatAndFlushes(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := NewContext(standard.NewRequest(req), standard.NewResponse(rec), New())
+
+	if err := c.SSE("ping", "hello"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ct := rec.Header().Get(ContentType); ct != "text/event-stream" {
+		t.Errorf("Content-Type = %q, want %q", ct, "text/event-stream")
+	}
+	want := "event: ping\ndata: hello\n\n"
+	if got := rec.Body.String(); got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+	if !rec.Flushed {
+		t.Error("expected the response to be flushed")
+	}
+}
@@ -0,0 +1,21 @@
+package echo
+
+import "time"
+
+type (
+	// HandlerFunc is the function signature for registered route handlers.
+	HandlerFunc func(Context) error
+
+	// MiddlewareFunc is the function signature for middleware.
+	MiddlewareFunc func(HandlerFunc) HandlerFunc
+)
+
+// WithTimeout returns middleware that cancels the request Context after d.
+func WithTimeout(d time.Duration) MiddlewareFunc {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(c Context) error {
+			c.SetDeadline(time.Now().Add(d))
+			return next(c)
+		}
+	}
+}